@@ -0,0 +1,28 @@
+package main
+
+import (
+	"log/slog"
+
+	"movies.jackmartin.net/internal/data"
+	"movies.jackmartin.net/internal/jobs"
+	"movies.jackmartin.net/internal/tmdb"
+)
+
+type config struct {
+	port int
+	env  string
+	db   struct {
+		dsn string
+	}
+	tmdb struct {
+		apiToken string
+	}
+}
+
+type application struct {
+	config config
+	logger *slog.Logger
+	models data.Models
+	jobs   jobs.Queue
+	tmdb   *tmdb.Client
+}
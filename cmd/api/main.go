@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"movies.jackmartin.net/internal/data"
+	"movies.jackmartin.net/internal/db/migrations"
+	"movies.jackmartin.net/internal/jobs"
+	"movies.jackmartin.net/internal/tmdb"
+)
+
+func main() {
+	var cfg config
+	var migrateCmd string
+
+	flag.IntVar(&cfg.port, "port", 4000, "API server port")
+	flag.StringVar(&cfg.env, "env", "development", "Environment (development|staging|production)")
+	flag.StringVar(&cfg.db.dsn, "db-dsn", os.Getenv("MOVIES_DB_DSN"), "PostgreSQL DSN")
+	flag.StringVar(&cfg.tmdb.apiToken, "tmdb-api-token", os.Getenv("TMDB_API_TOKEN"), "TMDB API read access token")
+	flag.StringVar(&migrateCmd, "migrate", "", "Run schema migrations and exit: up, down, or status")
+	flag.Parse()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	db, err := openDB(cfg)
+	if err != nil {
+		logger.Error("failed to open db", "error", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	if migrateCmd != "" {
+		if err := runMigrateCommand(context.Background(), db, logger, migrateCmd); err != nil {
+			logger.Error("migrate: failed", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := migrations.Up(context.Background(), db); err != nil {
+		logger.Error("migrate: failed to apply pending migrations", "error", err)
+		os.Exit(1)
+	}
+
+	app := &application{
+		config: cfg,
+		logger: logger,
+		models: data.NewModels(db),
+		jobs:   jobs.Queue{DB: db},
+		tmdb:   tmdb.New(cfg.tmdb.apiToken),
+	}
+
+	srv := &http.Server{
+		Addr:         fmt.Sprintf(":%d", cfg.port),
+		Handler:      app.routes(),
+		IdleTimeout:  time.Minute,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+
+	logger.Info("starting server", "addr", srv.Addr, "env", cfg.env)
+
+	err = srv.ListenAndServe()
+	logger.Error(err.Error())
+	os.Exit(1)
+}
+
+func openDB(cfg config) (*sql.DB, error) {
+	db, err := sql.Open("postgres", cfg.db.dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func runMigrateCommand(ctx context.Context, db *sql.DB, logger *slog.Logger, cmd string) error {
+	switch cmd {
+	case "up":
+		return migrations.Up(ctx, db)
+	case "down":
+		return migrations.Down(ctx, db)
+	case "status":
+		statuses, err := migrations.ListStatus(ctx, db)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			logger.Info("migration", "version", s.Version, "name", s.Name, "applied", s.Applied)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown -migrate value %q (want up, down, or status)", cmd)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"movies.jackmartin.net/internal/data"
+	"movies.jackmartin.net/internal/jobs"
+	"movies.jackmartin.net/internal/tmdb"
+)
+
+func (app *application) importMoviesHandler(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		TMDBIDs []int64 `json:"tmdb_ids"`
+	}
+
+	if err := app.readJSON(w, r, &input); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	if len(input.TMDBIDs) == 0 {
+		app.badRequestResponse(w, r, errors.New("tmdb_ids must contain at least one id"))
+		return
+	}
+
+	movies := make([]*data.Movie, 0, len(input.TMDBIDs))
+
+	for _, tmdbID := range input.TMDBIDs {
+		remote, err := app.tmdb.GetMovie(r.Context(), tmdbID)
+		if err != nil {
+			app.serviceUnavailableResponse(w, r, err)
+			return
+		}
+
+		movie := movieFromTMDB(remote)
+
+		if err := app.models.Movies.Upsert(movie); err != nil {
+			app.serverErrorResponse(w, r, err)
+			return
+		}
+
+		imageCachePayload := struct {
+			MovieID     int64  `json:"movie_id"`
+			PosterURL   string `json:"poster_url"`
+			BackdropURL string `json:"backdrop_url"`
+		}{MovieID: movie.ID, PosterURL: movie.PosterURL, BackdropURL: movie.BackdropURL}
+
+		if _, err := app.jobs.Enqueue(r.Context(), jobs.TypeImageCache, imageCachePayload); err != nil {
+			app.serverErrorResponse(w, r, err)
+			return
+		}
+
+		movies = append(movies, movie)
+	}
+
+	err := app.writeJSON(w, http.StatusOK, envelope{"movies": movies}, nil)
+	if err != nil {
+		app.serverErrorResponse(w, r, err)
+	}
+}
+
+func (app *application) refreshMovieHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := app.readIDParam(r)
+	if err != nil {
+		app.notFoundResponse(w, r)
+		return
+	}
+
+	movie, err := app.models.Movies.Get(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, data.ErrRecordNotFound):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+
+	if movie.TMDBID == 0 {
+		app.badRequestResponse(w, r, errors.New("movie was not imported from tmdb"))
+		return
+	}
+
+	payload := struct {
+		MovieID int64 `json:"movie_id"`
+		TMDBID  int64 `json:"tmdb_id"`
+	}{MovieID: movie.ID, TMDBID: movie.TMDBID}
+
+	job, err := app.jobs.Enqueue(r.Context(), jobs.TypeTMDBRefresh, payload)
+	if err != nil {
+		app.serverErrorResponse(w, r, err)
+		return
+	}
+
+	err = app.writeJSON(w, http.StatusAccepted, envelope{"job": job}, nil)
+	if err != nil {
+		app.serverErrorResponse(w, r, err)
+	}
+}
+
+func movieFromTMDB(remote *tmdb.Movie) *data.Movie {
+	releaseDate, _ := time.Parse("2006-01-02", remote.ReleaseDate)
+
+	return &data.Movie{
+		Title:       remote.Title,
+		Overview:    remote.Overview,
+		Language:    remote.Language,
+		ReleaseDate: releaseDate,
+		Rating:      remote.VoteAverage,
+		PosterURL:   remote.PosterURL(),
+		BackdropURL: remote.FullBackdropURL(),
+		TMDBID:      remote.TMDBID,
+		Genres:      remote.GenreNames(),
+	}
+}
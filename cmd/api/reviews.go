@@ -0,0 +1,77 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+
+	"movies.jackmartin.net/internal/data"
+	"movies.jackmartin.net/internal/jobs"
+)
+
+func (app *application) listMovieReviewsHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := app.readIDParam(r)
+	if err != nil {
+		app.notFoundResponse(w, r)
+		return
+	}
+
+	reviews, err := app.models.Reviews.GetAllForMovie(id)
+	if err != nil {
+		app.serverErrorResponse(w, r, err)
+		return
+	}
+
+	err = app.writeJSON(w, http.StatusOK, envelope{"reviews": reviews}, nil)
+	if err != nil {
+		app.serverErrorResponse(w, r, err)
+	}
+}
+
+func (app *application) scrapeMovieReviewsHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := app.readIDParam(r)
+	if err != nil {
+		app.notFoundResponse(w, r)
+		return
+	}
+
+	movie, err := app.models.Movies.Get(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, data.ErrRecordNotFound):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+
+	if movie.IMDBID == "" {
+		app.badRequestResponse(w, r, errors.New("movie has no imdb_id to scrape"))
+		return
+	}
+
+	payload := struct {
+		MovieID int64  `json:"movie_id"`
+		IMDBID  string `json:"imdb_id"`
+	}{MovieID: movie.ID, IMDBID: movie.IMDBID}
+
+	job, err := app.jobs.Enqueue(r.Context(), jobs.TypeIMDBScrape, payload)
+	if err != nil {
+		app.serverErrorResponse(w, r, err)
+		return
+	}
+
+	titleScanPayload := struct {
+		MovieID int64 `json:"movie_id"`
+	}{MovieID: movie.ID}
+
+	if _, err := app.jobs.Enqueue(r.Context(), jobs.TypeTitleMentionScan, titleScanPayload); err != nil {
+		app.serverErrorResponse(w, r, err)
+		return
+	}
+
+	err = app.writeJSON(w, http.StatusAccepted, envelope{"job": job}, nil)
+	if err != nil {
+		app.serverErrorResponse(w, r, err)
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func (app *application) routes() http.Handler {
+	router := httprouter.New()
+
+	router.NotFound = http.HandlerFunc(app.notFoundResponse)
+
+	router.HandlerFunc(http.MethodGet, "/v1/movies/:id/reviews", app.listMovieReviewsHandler)
+	router.HandlerFunc(http.MethodPost, "/v1/movies/:id/reviews/scrape", app.scrapeMovieReviewsHandler)
+	// Deliberately /v1/imports rather than the originally requested
+	// /v1/movies/import: httprouter panics at startup on a static "import"
+	// segment sharing a tree position with the ":id" wildcard used by the
+	// movie routes below, so the literal path was moved out from under
+	// /v1/movies.
+	router.HandlerFunc(http.MethodPost, "/v1/imports", app.importMoviesHandler)
+	router.HandlerFunc(http.MethodPost, "/v1/movies/:id/refresh", app.refreshMovieHandler)
+	router.HandlerFunc(http.MethodGet, "/v1/jobs/:id", app.showJobHandler)
+
+	return router
+}
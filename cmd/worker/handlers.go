@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"movies.jackmartin.net/internal/data"
+	"movies.jackmartin.net/internal/jobs"
+	"movies.jackmartin.net/internal/scraper"
+	"movies.jackmartin.net/internal/tmdb"
+)
+
+// tmdbRefreshHandler re-pulls fresh metadata for a movie from TMDB and
+// applies it through the optimistic-lock Update path.
+func tmdbRefreshHandler(models data.Models, client *tmdb.Client) jobs.Handler {
+	return func(ctx context.Context, job *jobs.Job) error {
+		var payload struct {
+			MovieID int64 `json:"movie_id"`
+			TMDBID  int64 `json:"tmdb_id"`
+		}
+
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return err
+		}
+
+		remote, err := client.GetMovie(ctx, payload.TMDBID)
+		if err != nil {
+			return err
+		}
+
+		movie, err := models.Movies.Get(payload.MovieID)
+		if err != nil {
+			return err
+		}
+
+		releaseDate, _ := time.Parse("2006-01-02", remote.ReleaseDate)
+
+		movie.Title = remote.Title
+		movie.Overview = remote.Overview
+		movie.Language = remote.Language
+		movie.ReleaseDate = releaseDate
+		movie.Rating = remote.VoteAverage
+		movie.PosterURL = remote.PosterURL()
+		movie.BackdropURL = remote.FullBackdropURL()
+		movie.Genres = remote.GenreNames()
+
+		return models.Movies.Update(movie)
+	}
+}
+
+// imdbScrapeHandler fetches and persists IMDB reviews for the movie named
+// in the job payload.
+func imdbScrapeHandler(models data.Models) jobs.Handler {
+	return func(ctx context.Context, job *jobs.Job) error {
+		var payload struct {
+			MovieID int64  `json:"movie_id"`
+			IMDBID  string `json:"imdb_id"`
+		}
+
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return err
+		}
+
+		scraped, err := scraper.New().FetchReviews(ctx, payload.IMDBID)
+		if err != nil {
+			return err
+		}
+
+		return models.WithTx(ctx, func(tx data.Models) error {
+			for _, sr := range scraped {
+				review := &data.Review{
+					MovieID:         payload.MovieID,
+					Source:          sr.Source,
+					URL:             sr.URL,
+					Text:            sr.Text,
+					MovieRating:     sr.MovieRating,
+					Quality:         "unverified",
+					MentionedTitles: sr.MentionedTitles,
+				}
+
+				if err := tx.Reviews.Insert(review); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+}
+
+// imageCacheHandler downloads a movie's poster and backdrop into cacheDir
+// so the API can serve them without round-tripping to TMDB on every
+// request.
+func imageCacheHandler(cacheDir string) jobs.Handler {
+	return func(ctx context.Context, job *jobs.Job) error {
+		var payload struct {
+			MovieID     int64  `json:"movie_id"`
+			PosterURL   string `json:"poster_url"`
+			BackdropURL string `json:"backdrop_url"`
+		}
+
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return err
+		}
+
+		if err := os.MkdirAll(cacheDir, 0o755); err != nil {
+			return err
+		}
+
+		if payload.PosterURL != "" {
+			dst := filepath.Join(cacheDir, fmt.Sprintf("%d-poster.jpg", payload.MovieID))
+			if err := downloadFile(ctx, payload.PosterURL, dst); err != nil {
+				return err
+			}
+		}
+
+		if payload.BackdropURL != "" {
+			dst := filepath.Join(cacheDir, fmt.Sprintf("%d-backdrop.jpg", payload.MovieID))
+			if err := downloadFile(ctx, payload.BackdropURL, dst); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func downloadFile(ctx context.Context, url, dst string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("image cache: unexpected status %d fetching %s", resp.StatusCode, url)
+	}
+
+	file, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, resp.Body)
+	return err
+}
+
+// titleMentionScanHandler re-runs title-mention extraction over a movie's
+// stored reviews, picking up improvements to the extraction heuristic
+// without needing to re-scrape.
+func titleMentionScanHandler(models data.Models) jobs.Handler {
+	return func(ctx context.Context, job *jobs.Job) error {
+		var payload struct {
+			MovieID int64 `json:"movie_id"`
+		}
+
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return err
+		}
+
+		reviews, err := models.Reviews.GetAllForMovie(payload.MovieID)
+		if err != nil {
+			return err
+		}
+
+		for _, review := range reviews {
+			review.MentionedTitles = scraper.ExtractMentionedTitles(review.Text)
+
+			if err := models.Reviews.Update(review); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
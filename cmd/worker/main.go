@@ -0,0 +1,71 @@
+// Command worker runs the background enrichment pool: TMDB metadata
+// refreshes, IMDB review scraping, poster/backdrop caching and
+// title-mention extraction, all driven off the Postgres-backed jobs
+// queue populated by the API.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"movies.jackmartin.net/internal/data"
+	"movies.jackmartin.net/internal/jobs"
+	"movies.jackmartin.net/internal/tmdb"
+)
+
+func main() {
+	var dsn string
+	var concurrency int
+	var tmdbAPIToken string
+	var imageCacheDir string
+	var jobTimeout time.Duration
+
+	flag.StringVar(&dsn, "db-dsn", os.Getenv("MOVIES_DB_DSN"), "PostgreSQL DSN")
+	flag.IntVar(&concurrency, "concurrency", 4, "number of concurrent job workers")
+	flag.StringVar(&tmdbAPIToken, "tmdb-api-token", os.Getenv("TMDB_API_TOKEN"), "TMDB API read access token")
+	flag.StringVar(&imageCacheDir, "image-cache-dir", "./cache/images", "directory to cache poster/backdrop images in")
+	flag.DurationVar(&jobTimeout, "job-timeout", 30*time.Second, "maximum time a single job handler may run before it is treated as failed")
+	flag.Parse()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		logger.Error("worker: failed to open db", "error", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	models := data.NewModels(db)
+	queue := jobs.Queue{DB: db}
+	tmdbClient := tmdb.New(tmdbAPIToken)
+
+	pool := &jobs.Pool{
+		Queue:          queue,
+		Concurrency:    concurrency,
+		PollInterval:   time.Second,
+		HandlerTimeout: jobTimeout,
+		Logger:         logger,
+		Handlers: map[jobs.Type]jobs.Handler{
+			jobs.TypeTMDBRefresh:      tmdbRefreshHandler(models, tmdbClient),
+			jobs.TypeIMDBScrape:       imdbScrapeHandler(models),
+			jobs.TypeImageCache:       imageCacheHandler(imageCacheDir),
+			jobs.TypeTitleMentionScan: titleMentionScanHandler(models),
+		},
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logger.Info("worker: starting", "concurrency", concurrency)
+	pool.Run(ctx)
+	logger.Info("worker: stopped")
+}
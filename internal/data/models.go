@@ -0,0 +1,58 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+var (
+	ErrRecordNotFound = errors.New("record not found")
+	ErrEditConflict   = errors.New("edit conflict")
+)
+
+// DBTX is satisfied by both *sql.DB and *sql.Tx, letting model methods run
+// either against the connection pool directly or inside a transaction.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type Models struct {
+	Movies  MovieModel
+	Reviews ReviewModel
+
+	db *sql.DB
+}
+
+func NewModels(db *sql.DB) Models {
+	return Models{
+		Movies:  MovieModel{DB: db},
+		Reviews: ReviewModel{DB: db},
+		db:      db,
+	}
+}
+
+// WithTx begins a transaction against the underlying connection pool and
+// passes fn a Models whose query methods run inside it. The transaction is
+// committed if fn returns nil, and rolled back otherwise.
+func (m Models) WithTx(ctx context.Context, fn func(Models) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	txModels := Models{
+		Movies:  MovieModel{DB: tx},
+		Reviews: ReviewModel{DB: tx},
+		db:      m.db,
+	}
+
+	if err := fn(txModels); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
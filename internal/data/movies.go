@@ -20,19 +20,21 @@ type Movie struct {
 	Rating      float32   `json:"vote_average"`
 	PosterURL   string    `json:"poster_url"`
 	BackdropURL string    `json:"backdrop_url"`
+	IMDBID      string    `json:"imdb_id,omitempty"`
+	TMDBID      int64     `json:"tmdb_id,omitempty"`
 	Genres      []string  `json:"genres"`
 	Version     int32     `json:"version"`
 	CreatedAt   time.Time `json:"-"`
 }
 
 type MovieModel struct {
-	DB *sql.DB
+	DB DBTX
 }
 
 func (m MovieModel) Insert(movie *Movie) error {
 	query := `
-    INSERT INTO movies (title, overview, language, release_date, rating, poster_url, backdrop_url, genres) 
-    VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    INSERT INTO movies (title, overview, language, release_date, rating, poster_url, backdrop_url, imdb_id, genres)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
     RETURNING id, created_at, version`
 
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
@@ -47,6 +49,44 @@ func (m MovieModel) Insert(movie *Movie) error {
 		movie.Rating,
 		movie.PosterURL,
 		movie.BackdropURL,
+		movie.IMDBID,
+		pq.Array(movie.Genres),
+	}
+
+	return m.DB.QueryRowContext(ctx, query, args...).Scan(&movie.ID, &movie.CreatedAt, &movie.Version)
+}
+
+// Upsert inserts a movie sourced from TMDB, or updates the existing row
+// for that tmdb_id if one has already been imported.
+func (m MovieModel) Upsert(movie *Movie) error {
+	query := `
+    INSERT INTO movies (title, overview, language, release_date, rating, poster_url, backdrop_url, tmdb_id, genres)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    ON CONFLICT (tmdb_id) DO UPDATE SET
+        title = EXCLUDED.title,
+        overview = EXCLUDED.overview,
+        language = EXCLUDED.language,
+        release_date = EXCLUDED.release_date,
+        rating = EXCLUDED.rating,
+        poster_url = EXCLUDED.poster_url,
+        backdrop_url = EXCLUDED.backdrop_url,
+        genres = EXCLUDED.genres,
+        version = movies.version + 1
+    RETURNING id, created_at, version`
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+
+	defer cancel()
+
+	args := []any{
+		movie.Title,
+		movie.Overview,
+		movie.Language,
+		movie.ReleaseDate,
+		movie.Rating,
+		movie.PosterURL,
+		movie.BackdropURL,
+		movie.TMDBID,
 		pq.Array(movie.Genres),
 	}
 
@@ -59,11 +99,12 @@ func (m MovieModel) Get(id int64) (*Movie, error) {
 	}
 
 	query := `
-    SELECT id, created_at, title, overview, language, release_date, rating, poster_url, backdrop_url, genres, version
+    SELECT id, created_at, title, overview, language, release_date, rating, poster_url, backdrop_url, imdb_id, tmdb_id, genres, version
     FROM movies
     WHERE id = $1`
 
 	var movie Movie
+	var tmdbID sql.NullInt64
 
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 
@@ -79,6 +120,8 @@ func (m MovieModel) Get(id int64) (*Movie, error) {
 		&movie.Rating,
 		&movie.PosterURL,
 		&movie.BackdropURL,
+		&movie.IMDBID,
+		&tmdbID,
 		pq.Array(&movie.Genres),
 		&movie.Version,
 	)
@@ -92,12 +135,14 @@ func (m MovieModel) Get(id int64) (*Movie, error) {
 		}
 	}
 
+	movie.TMDBID = tmdbID.Int64
+
 	return &movie, nil
 }
 
 func (m MovieModel) GetAll(title string, genres []string, filters Filters) ([]*Movie, Metadata, error) {
 	query := fmt.Sprintf(`
-    SELECT count(*) OVER(), id, created_at, title, overview, language, release_date, rating, poster_url, backdrop_url, genres, version
+    SELECT count(*) OVER(), id, created_at, title, overview, language, release_date, rating, poster_url, backdrop_url, imdb_id, tmdb_id, genres, version
     FROM movies
     WHERE (to_tsvector('simple', title) @@ plainto_tsquery('simple', $1) OR $1 = '')
     AND (genres @> $2 OR $2 = '{}')
@@ -122,6 +167,7 @@ func (m MovieModel) GetAll(title string, genres []string, filters Filters) ([]*M
 
 	for rows.Next() {
 		var movie Movie
+		var tmdbID sql.NullInt64
 
 		err := rows.Scan(
 			&totalRecords,
@@ -134,6 +180,8 @@ func (m MovieModel) GetAll(title string, genres []string, filters Filters) ([]*M
 			&movie.Rating,
 			&movie.PosterURL,
 			&movie.BackdropURL,
+			&movie.IMDBID,
+			&tmdbID,
 			pq.Array(&movie.Genres),
 			&movie.Version,
 		)
@@ -142,6 +190,8 @@ func (m MovieModel) GetAll(title string, genres []string, filters Filters) ([]*M
 			return nil, Metadata{}, err
 		}
 
+		movie.TMDBID = tmdbID.Int64
+
 		movies = append(movies, &movie)
 	}
 
@@ -156,15 +206,23 @@ func (m MovieModel) GetAll(title string, genres []string, filters Filters) ([]*M
 
 func (m MovieModel) Update(movie *Movie) error {
 	query := `
-    UPDATE movies 
-    SET title = $1, overview = $2, language = $3, release_date = $4, rating = $5, poster_url = $6, backdrop_url = $7, genres = $8, version = version + 1
-    WHERE id = $9 AND version = $10
+    UPDATE movies
+    SET title = $1, overview = $2, language = $3, release_date = $4, rating = $5, poster_url = $6, backdrop_url = $7, imdb_id = $8, tmdb_id = $9, genres = $10, version = version + 1
+    WHERE id = $11 AND version = $12
     RETURNING version`
 
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 
 	defer cancel()
 
+	// tmdb_id is nullable and carries a unique index: binding the zero
+	// value of movie.TMDBID would write a literal 0 instead of NULL, and a
+	// second movie without a tmdb_id would then collide with it.
+	var tmdbID sql.NullInt64
+	if movie.TMDBID != 0 {
+		tmdbID = sql.NullInt64{Int64: movie.TMDBID, Valid: true}
+	}
+
 	args := []any{
 		&movie.Title,
 		&movie.Overview,
@@ -173,6 +231,8 @@ func (m MovieModel) Update(movie *Movie) error {
 		&movie.Rating,
 		&movie.PosterURL,
 		&movie.BackdropURL,
+		&movie.IMDBID,
+		tmdbID,
 		pq.Array(&movie.Genres),
 		movie.ID,
 		movie.Version,
@@ -0,0 +1,233 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+type Review struct {
+	ID              int64     `json:"id"`
+	MovieID         int64     `json:"movie_id"`
+	Source          string    `json:"source"`
+	URL             string    `json:"url"`
+	Text            string    `json:"text"`
+	MovieRating     float32   `json:"movie_rating"`
+	Quality         string    `json:"quality"`
+	MentionedTitles []string  `json:"mentioned_titles"`
+	CreatedAt       time.Time `json:"-"`
+}
+
+type ReviewModel struct {
+	DB DBTX
+}
+
+// Insert writes a review, silently doing nothing if a review from the same
+// source URL for the same movie already exists. This makes Insert safe to
+// call more than once for the same scrape result, which the jobs queue's
+// at-least-once delivery relies on.
+func (m ReviewModel) Insert(review *Review) error {
+	query := `
+    INSERT INTO reviews (movie_id, source, url, text, movie_rating, quality, mentioned_titles)
+    VALUES ($1, $2, $3, $4, $5, $6, $7)
+    ON CONFLICT (movie_id, url) DO NOTHING
+    RETURNING id, created_at`
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+
+	defer cancel()
+
+	mentionedTitles, err := json.Marshal(review.MentionedTitles)
+	if err != nil {
+		return err
+	}
+
+	args := []any{
+		review.MovieID,
+		review.Source,
+		review.URL,
+		review.Text,
+		review.MovieRating,
+		review.Quality,
+		mentionedTitles,
+	}
+
+	err = m.DB.QueryRowContext(ctx, query, args...).Scan(&review.ID, &review.CreatedAt)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	return err
+}
+
+func (m ReviewModel) Get(id int64) (*Review, error) {
+	if id < 1 {
+		return nil, ErrRecordNotFound
+	}
+
+	query := `
+    SELECT id, movie_id, created_at, source, url, text, movie_rating, quality, mentioned_titles
+    FROM reviews
+    WHERE id = $1`
+
+	var review Review
+	var mentionedTitles []byte
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+
+	defer cancel()
+
+	err := m.DB.QueryRowContext(ctx, query, id).Scan(
+		&review.ID,
+		&review.MovieID,
+		&review.CreatedAt,
+		&review.Source,
+		&review.URL,
+		&review.Text,
+		&review.MovieRating,
+		&review.Quality,
+		&mentionedTitles,
+	)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	if err := json.Unmarshal(mentionedTitles, &review.MentionedTitles); err != nil {
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+func (m ReviewModel) GetAllForMovie(movieID int64) ([]*Review, error) {
+	query := `
+    SELECT id, movie_id, created_at, source, url, text, movie_rating, quality, mentioned_titles
+    FROM reviews
+    WHERE movie_id = $1
+    ORDER BY created_at DESC`
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+
+	defer cancel()
+
+	rows, err := m.DB.QueryContext(ctx, query, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	reviews := []*Review{}
+
+	for rows.Next() {
+		var review Review
+		var mentionedTitles []byte
+
+		err := rows.Scan(
+			&review.ID,
+			&review.MovieID,
+			&review.CreatedAt,
+			&review.Source,
+			&review.URL,
+			&review.Text,
+			&review.MovieRating,
+			&review.Quality,
+			&mentionedTitles,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(mentionedTitles, &review.MentionedTitles); err != nil {
+			return nil, err
+		}
+
+		reviews = append(reviews, &review)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+func (m ReviewModel) Update(review *Review) error {
+	query := `
+    UPDATE reviews
+    SET source = $1, url = $2, text = $3, movie_rating = $4, quality = $5, mentioned_titles = $6
+    WHERE id = $7`
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+
+	defer cancel()
+
+	mentionedTitles, err := json.Marshal(review.MentionedTitles)
+	if err != nil {
+		return err
+	}
+
+	args := []any{
+		review.Source,
+		review.URL,
+		review.Text,
+		review.MovieRating,
+		review.Quality,
+		mentionedTitles,
+		review.ID,
+	}
+
+	result, err := m.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (m ReviewModel) Delete(id int64) error {
+	if id < 1 {
+		return ErrRecordNotFound
+	}
+
+	query := `
+    DELETE FROM reviews
+    WHERE id = $1`
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+
+	defer cancel()
+
+	result, err := m.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+// Package migrations applies the application's versioned SQL schema
+// migrations. Files are embedded into the binary at build time so that
+// deployments only ever need the compiled executable, never a separate
+// migrations directory on disk.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+const upMarker = "-- +migrate Up"
+const downMarker = "-- +migrate Down"
+
+// Migration is a single versioned schema change parsed from an embedded
+// .sql file containing "-- +migrate Up" / "-- +migrate Down" sections.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status describes whether a migration has been applied.
+type Status struct {
+	Version int
+	Name    string
+	Applied bool
+}
+
+func load() ([]Migration, error) {
+	entries, err := files.ReadDir(".")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]Migration, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+
+		version, name, err := parseFilename(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		contents, err := files.ReadFile(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		up, down, err := splitSections(string(contents))
+		if err != nil {
+			return nil, fmt.Errorf("migrations: %s: %w", entry.Name(), err)
+		}
+
+		migrations = append(migrations, Migration{Version: version, Name: name, Up: up, Down: down})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+func parseFilename(filename string) (int, string, error) {
+	base := strings.TrimSuffix(filename, ".sql")
+	parts := strings.SplitN(base, "_", 2)
+
+	version, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, "", fmt.Errorf("migrations: invalid filename %q: missing numeric version prefix", filename)
+	}
+
+	name := base
+	if len(parts) == 2 {
+		name = parts[1]
+	}
+
+	return version, name, nil
+}
+
+func splitSections(contents string) (up, down string, err error) {
+	upIdx := strings.Index(contents, upMarker)
+	downIdx := strings.Index(contents, downMarker)
+
+	if upIdx == -1 || downIdx == -1 || downIdx < upIdx {
+		return "", "", fmt.Errorf("must contain a %q section followed by a %q section", upMarker, downMarker)
+	}
+
+	up = strings.TrimSpace(contents[upIdx+len(upMarker) : downIdx])
+	down = strings.TrimSpace(contents[downIdx+len(downMarker):])
+
+	return up, down, nil
+}
+
+func ensureSchemaMigrationsTable(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `
+    CREATE TABLE IF NOT EXISTS schema_migrations (
+        version bigint PRIMARY KEY,
+        name text NOT NULL,
+        applied_at timestamp(0) with time zone NOT NULL DEFAULT NOW()
+    )`)
+	return err
+}
+
+func appliedVersions(ctx context.Context, db *sql.DB) (map[int]bool, error) {
+	rows, err := db.QueryContext(ctx, `SELECT version FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]bool)
+
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
+
+// Up applies every migration that has not yet been recorded in
+// schema_migrations, each inside its own transaction, in version order.
+func Up(ctx context.Context, db *sql.DB) error {
+	if err := ensureSchemaMigrationsTable(ctx, db); err != nil {
+		return err
+	}
+
+	migrations, err := load()
+	if err != nil {
+		return err
+	}
+
+	applied, err := appliedVersions(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if applied[m.Version] {
+			continue
+		}
+
+		if err := runInTx(ctx, db, m.Up, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, m.Version, m.Name)
+			return err
+		}); err != nil {
+			return fmt.Errorf("migrations: applying %04d_%s: %w", m.Version, m.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Down rolls back the single most recently applied migration.
+func Down(ctx context.Context, db *sql.DB) error {
+	if err := ensureSchemaMigrationsTable(ctx, db); err != nil {
+		return err
+	}
+
+	migrations, err := load()
+	if err != nil {
+		return err
+	}
+
+	applied, err := appliedVersions(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	var target *Migration
+	for i := len(migrations) - 1; i >= 0; i-- {
+		if applied[migrations[i].Version] {
+			target = &migrations[i]
+			break
+		}
+	}
+
+	if target == nil {
+		return nil
+	}
+
+	return runInTx(ctx, db, target.Down, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, target.Version)
+		return err
+	})
+}
+
+// ListStatus reports, for every known migration, whether it has been
+// applied to the database.
+func ListStatus(ctx context.Context, db *sql.DB) ([]Status, error) {
+	if err := ensureSchemaMigrationsTable(ctx, db); err != nil {
+		return nil, err
+	}
+
+	migrations, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := appliedVersions(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, len(migrations))
+	for i, m := range migrations {
+		statuses[i] = Status{Version: m.Version, Name: m.Name, Applied: applied[m.Version]}
+	}
+
+	return statuses, nil
+}
+
+func runInTx(ctx context.Context, db *sql.DB, statement string, after func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, statement); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := after(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
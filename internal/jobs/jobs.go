@@ -0,0 +1,197 @@
+// Package jobs implements a small Postgres-backed durable job queue used
+// to run enrichment work (TMDB refreshes, IMDB scraping, image caching,
+// title-mention extraction) outside of the request/response cycle.
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+type Type string
+
+const (
+	TypeTMDBRefresh      Type = "tmdb_refresh"
+	TypeIMDBScrape       Type = "imdb_scrape"
+	TypeImageCache       Type = "image_cache"
+	TypeTitleMentionScan Type = "title_mention_scan"
+)
+
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+var ErrNotFound = errors.New("job not found")
+
+const maxAttempts = 5
+
+// Job is a single unit of enrichment work tracked in the jobs table.
+type Job struct {
+	ID        int64           `json:"id"`
+	Type      Type            `json:"type"`
+	Payload   json.RawMessage `json:"payload"`
+	Status    Status          `json:"status"`
+	Attempts  int             `json:"attempts"`
+	LastError string          `json:"last_error,omitempty"`
+	RunAfter  time.Time       `json:"run_after"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// Queue wraps a *sql.DB to enqueue and dequeue jobs using
+// SELECT ... FOR UPDATE SKIP LOCKED so multiple workers can safely poll
+// the same table concurrently.
+type Queue struct {
+	DB *sql.DB
+}
+
+// Enqueue inserts a new pending job, marshalling payload to JSON.
+func (q Queue) Enqueue(ctx context.Context, jobType Type, payload any) (*Job, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+    INSERT INTO jobs (type, payload, status, run_after)
+    VALUES ($1, $2, $3, NOW())
+    RETURNING id, status, attempts, run_after, created_at, updated_at`
+
+	job := &Job{Type: jobType, Payload: body}
+
+	err = q.DB.QueryRowContext(ctx, query, jobType, body, StatusPending).Scan(
+		&job.ID,
+		&job.Status,
+		&job.Attempts,
+		&job.RunAfter,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// Get fetches a job by ID for status polling.
+func (q Queue) Get(ctx context.Context, id int64) (*Job, error) {
+	query := `
+    SELECT id, type, payload, status, attempts, last_error, run_after, created_at, updated_at
+    FROM jobs
+    WHERE id = $1`
+
+	var job Job
+	var lastError sql.NullString
+
+	err := q.DB.QueryRowContext(ctx, query, id).Scan(
+		&job.ID,
+		&job.Type,
+		&job.Payload,
+		&job.Status,
+		&job.Attempts,
+		&lastError,
+		&job.RunAfter,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	job.LastError = lastError.String
+
+	return &job, nil
+}
+
+// claim claims the oldest due pending job, skipping rows already locked by
+// another worker, and marks it running. The claiming transaction is opened
+// and committed here only — it is never held open across handler
+// execution, so a slow or hung handler cannot pin a DB connection or a
+// locked row.
+func (q Queue) claim(ctx context.Context) (*Job, error) {
+	tx, err := q.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+    SELECT id, type, payload, status, attempts, run_after, created_at, updated_at
+    FROM jobs
+    WHERE status = $1 AND run_after <= NOW()
+    ORDER BY run_after ASC
+    FOR UPDATE SKIP LOCKED
+    LIMIT 1`
+
+	var job Job
+
+	err = tx.QueryRowContext(ctx, query, StatusPending).Scan(
+		&job.ID,
+		&job.Type,
+		&job.Payload,
+		&job.Status,
+		&job.Attempts,
+		&job.RunAfter,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE jobs SET status = $1, attempts = attempts + 1, updated_at = NOW() WHERE id = $2`, StatusRunning, job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	job.Status = StatusRunning
+	job.Attempts++
+
+	return &job, nil
+}
+
+// succeed records that a claimed job completed, in its own short
+// transaction.
+func (q Queue) succeed(ctx context.Context, id int64) error {
+	_, err := q.DB.ExecContext(ctx, `UPDATE jobs SET status = $1, last_error = '', updated_at = NOW() WHERE id = $2`, StatusSucceeded, id)
+	return err
+}
+
+// fail records the error and, if attempts remain, reschedules the job with
+// an exponential backoff; otherwise it marks the job permanently failed.
+// Like succeed, it runs in its own short transaction rather than one held
+// open across the handler call.
+func (q Queue) fail(ctx context.Context, job *Job, cause error) error {
+	if job.Attempts >= maxAttempts {
+		_, err := q.DB.ExecContext(ctx, `UPDATE jobs SET status = $1, last_error = $2, updated_at = NOW() WHERE id = $3`, StatusFailed, cause.Error(), job.ID)
+		return err
+	}
+
+	backoff := time.Duration(1<<uint(job.Attempts)) * time.Second
+
+	_, err := q.DB.ExecContext(ctx, `
+    UPDATE jobs
+    SET status = $1, last_error = $2, run_after = NOW() + $3::interval, updated_at = NOW()
+    WHERE id = $4`, StatusPending, cause.Error(), backoff.String(), job.ID)
+
+	return err
+}
@@ -0,0 +1,114 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Handler performs the work associated with a single job. Returning an
+// error causes the job to be retried with backoff (see Queue.fail).
+type Handler func(ctx context.Context, job *Job) error
+
+// Pool polls the queue with a fixed number of workers, dispatching each
+// claimed job to its registered Handler, and shuts down gracefully when
+// its context is cancelled.
+type Pool struct {
+	Queue          Queue
+	Handlers       map[Type]Handler
+	Concurrency    int
+	PollInterval   time.Duration
+	HandlerTimeout time.Duration
+	Logger         *slog.Logger
+}
+
+// Run starts the worker pool and blocks until ctx is cancelled and every
+// in-flight job has finished.
+func (p *Pool) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	concurrency := p.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.worker(ctx)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (p *Pool) worker(ctx context.Context) {
+	ticker := time.NewTicker(p.pollInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.runOnce(ctx)
+		}
+	}
+}
+
+func (p *Pool) runOnce(ctx context.Context) {
+	job, err := p.Queue.claim(ctx)
+	if err != nil {
+		p.Logger.Error("jobs: claim failed", "error", err)
+		return
+	}
+	if job == nil {
+		return
+	}
+
+	// The outcome is recorded even after ctx is cancelled, so a job
+	// interrupted by shutdown goes back to pending instead of staying running.
+	recordCtx := context.WithoutCancel(ctx)
+
+	handler, ok := p.Handlers[job.Type]
+	if !ok {
+		p.Logger.Error("jobs: no handler registered", "type", job.Type)
+		if err := p.Queue.fail(recordCtx, job,fmt.Errorf("no handler registered for job type %q", job.Type)); err != nil {
+			p.Logger.Error("jobs: failed to record failure", "id", job.ID, "error", err)
+		}
+		return
+	}
+
+	handlerCtx, cancel := context.WithTimeout(ctx, p.handlerTimeout())
+	err = handler(handlerCtx, job)
+	cancel()
+
+	if err != nil {
+		p.Logger.Error("jobs: handler failed", "id", job.ID, "type", job.Type, "error", err)
+		if err := p.Queue.fail(recordCtx, job, err); err != nil {
+			p.Logger.Error("jobs: failed to record failure", "id", job.ID, "error", err)
+		}
+		return
+	}
+
+	if err := p.Queue.succeed(recordCtx, job.ID); err != nil {
+		p.Logger.Error("jobs: failed to record success", "id", job.ID, "error", err)
+	}
+}
+
+func (p *Pool) pollInterval() time.Duration {
+	if p.PollInterval <= 0 {
+		return time.Second
+	}
+	return p.PollInterval
+}
+
+func (p *Pool) handlerTimeout() time.Duration {
+	if p.HandlerTimeout <= 0 {
+		return 30 * time.Second
+	}
+	return p.HandlerTimeout
+}
@@ -0,0 +1,121 @@
+// Package scraper fetches publicly available IMDB review pages and
+// extracts the review text, rating and any other movie titles mentioned
+// within the review body.
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const imdbReviewsURL = "https://www.imdb.com/title/%s/reviews"
+
+var titleMentionPattern = regexp.MustCompile(`"([A-Z][\w' ]{1,60})"`)
+
+// Review is a single IMDB review scraped from a movie's reviews page.
+type Review struct {
+	Source          string
+	URL             string
+	Text            string
+	MovieRating     float32
+	MentionedTitles []string
+}
+
+// Scraper fetches and parses IMDB review pages over HTTP.
+type Scraper struct {
+	Client *http.Client
+}
+
+// New returns a Scraper with a sensible request timeout.
+func New() *Scraper {
+	return &Scraper{
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// FetchReviews retrieves and parses the reviews page for the given IMDB ID.
+func (s *Scraper) FetchReviews(ctx context.Context, imdbID string) ([]Review, error) {
+	url := fmt.Sprintf(imdbReviewsURL, imdbID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; movies-api-scraper/1.0)")
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("scraper: unexpected status %d fetching %s", resp.StatusCode, url)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviews []Review
+
+	doc.Find(".review-container").Each(func(_ int, sel *goquery.Selection) {
+		text := strings.TrimSpace(sel.Find(".text.show-more__control").Text())
+		if text == "" {
+			return
+		}
+
+		var rating float32
+		ratingText := strings.TrimSpace(sel.Find(".ratings-bar .rating-other-user-rating span").First().Text())
+		if parsed, err := strconv.ParseFloat(ratingText, 32); err == nil {
+			rating = float32(parsed)
+		}
+
+		reviewURL := url
+		if href, ok := sel.Find("a.title").Attr("href"); ok && strings.HasPrefix(href, "/") {
+			reviewURL = "https://www.imdb.com" + href
+		}
+
+		reviews = append(reviews, Review{
+			Source:          "imdb",
+			URL:             reviewURL,
+			Text:            text,
+			MovieRating:     rating,
+			MentionedTitles: ExtractMentionedTitles(text),
+		})
+	})
+
+	return reviews, nil
+}
+
+// ExtractMentionedTitles pulls out quoted, capitalised phrases from review
+// text as a best-effort signal that another movie title was mentioned.
+func ExtractMentionedTitles(text string) []string {
+	matches := titleMentionPattern.FindAllStringSubmatch(text, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	titles := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		title := strings.TrimSpace(match[1])
+		if title == "" || seen[title] {
+			continue
+		}
+		seen[title] = true
+		titles = append(titles, title)
+	}
+
+	return titles
+}
@@ -0,0 +1,106 @@
+// Package tmdb is a minimal client for the subset of The Movie Database
+// (TMDB) API used to populate and refresh entries in the local catalog.
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	baseURL      = "https://api.themoviedb.org/3"
+	imageBaseURL = "https://image.tmdb.org/t/p/w780"
+)
+
+// Movie is the subset of TMDB's movie details response the catalog cares
+// about.
+type Movie struct {
+	TMDBID      int64   `json:"id"`
+	Title       string  `json:"title"`
+	Overview    string  `json:"overview"`
+	Language    string  `json:"original_language"`
+	ReleaseDate string  `json:"release_date"`
+	VoteAverage float32 `json:"vote_average"`
+	PosterPath  string  `json:"poster_path"`
+	BackdropURL string  `json:"backdrop_path"`
+	Genres      []Genre `json:"genres"`
+}
+
+type Genre struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// PosterURL returns the fully-qualified image URL for the movie's poster,
+// or an empty string if TMDB has none on file.
+func (m Movie) PosterURL() string {
+	if m.PosterPath == "" {
+		return ""
+	}
+	return imageBaseURL + m.PosterPath
+}
+
+// FullBackdropURL returns the fully-qualified image URL for the movie's
+// backdrop, or an empty string if TMDB has none on file.
+func (m Movie) FullBackdropURL() string {
+	if m.BackdropURL == "" {
+		return ""
+	}
+	return imageBaseURL + m.BackdropURL
+}
+
+// GenreNames flattens the genre list into plain names for storage.
+func (m Movie) GenreNames() []string {
+	names := make([]string, len(m.Genres))
+	for i, g := range m.Genres {
+		names[i] = g.Name
+	}
+	return names
+}
+
+// Client calls the TMDB API using a read access token (v4 auth).
+type Client struct {
+	APIToken string
+	HTTP     *http.Client
+}
+
+// New returns a Client with a sensible request timeout.
+func New(apiToken string) *Client {
+	return &Client{
+		APIToken: apiToken,
+		HTTP:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// GetMovie fetches the movie details for the given TMDB ID.
+func (c *Client) GetMovie(ctx context.Context, tmdbID int64) (*Movie, error) {
+	url := fmt.Sprintf("%s/movie/%d", baseURL, tmdbID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.APIToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("tmdb: unexpected status %d fetching movie %d", resp.StatusCode, tmdbID)
+	}
+
+	var movie Movie
+	if err := json.NewDecoder(resp.Body).Decode(&movie); err != nil {
+		return nil, err
+	}
+
+	return &movie, nil
+}